@@ -0,0 +1,163 @@
+package interval
+
+// Union, Intersect and Difference all operate on the canonical (sorted,
+// disjoint) form of their operands: the receiver is brought to that form via
+// mergedSorted and the other operand via canonicalOf, and the two are then
+// combined with a single two-pointer sweep, giving O(n+m) behaviour instead
+// of re-sorting the concatenation of both sides.
+//
+// Like the rest of this package (see Gaps in intervals.go, which already
+// treats High+1 as touching the next Low), every interval here
+// is a fully-inclusive [Low, High] range of integers; there is no
+// IsLowInclusive/IsHighInclusive toggle to honor because the intervals
+// struct does not carry one.
+
+// mergedSorted sorts intvls in place (as Gaps and Overlapped do) and returns
+// a sorted, non-overlapping copy of intvls.Intervals; the receiver's own
+// intervals are reordered but not merged.
+func (intvls *intervals) mergedSorted() []*Interval {
+	intvls.Sort()
+	return coalesce(intvls.Intervals)
+}
+
+// canonicalOf returns the sorted, non-overlapping intervals covered by
+// other, derived purely through the public Intervals interface (Bounds and
+// Gaps: the canonical intervals are exactly the complement of the gaps
+// within [minLow, maxHigh]). This lets Union/Intersect/Difference accept
+// any Intervals implementation, not just this package's own slice-backed
+// one.
+func canonicalOf(other Intervals) []*Interval {
+	minLow, maxHigh := other.Bounds()
+	gaps := other.Gaps()
+
+	canonical := []*Interval{}
+	cursor := minLow
+	for _, gap := range gaps {
+		if cursor < gap.Low {
+			canonical = append(canonical, &Interval{Low: cursor, High: gap.Low - 1})
+		}
+		cursor = gap.High + 1
+	}
+	if cursor <= maxHigh {
+		canonical = append(canonical, &Interval{Low: cursor, High: maxHigh})
+	}
+	return canonical
+}
+
+// coalesce collapses a Low-sorted slice of intervals into its canonical
+// form: overlapping and touching intervals (High+1 == next Low) are merged
+// into a single interval. The input is not mutated.
+func coalesce(sorted []*Interval) []*Interval {
+	if len(sorted) == 0 {
+		return []*Interval{}
+	}
+	merged := []*Interval{{Low: sorted[0].Low, High: sorted[0].High}}
+	for _, intvl := range sorted[1:] {
+		last := merged[len(merged)-1]
+		if intvl.Low <= last.High+1 {
+			if intvl.High > last.High {
+				last.High = intvl.High
+			}
+			continue
+		}
+		merged = append(merged, &Interval{Low: intvl.Low, High: intvl.High})
+	}
+	return merged
+}
+
+// mergeSorted merges two already Low-sorted slices into a single Low-sorted
+// slice in O(n+m), the same way the merge step of a merge sort works.
+func mergeSorted(a, b []*Interval) []*Interval {
+	merged := make([]*Interval, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i].Low <= b[j].Low {
+			merged = append(merged, a[i])
+			i++
+		} else {
+			merged = append(merged, b[j])
+			j++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	merged = append(merged, b[j:]...)
+	return merged
+}
+
+// newResult builds an empty Intervals sharing the receiver's bounds and
+// seeds it with an already-canonical (sorted, disjoint) slice.
+func (intvls *intervals) newResult(canonical []*Interval) Intervals {
+	return &intervals{
+		MinLow:    intvls.MinLow,
+		MaxHigh:   intvls.MaxHigh,
+		Intervals: canonical,
+		Sorted:    true,
+	}
+}
+
+// Merge sorts intvls in place and coalesces overlapping or touching
+// intervals ([a,b], [b+1,c] -> [a,c]) into the canonical sorted-disjoint
+// form.
+func (intvls *intervals) Merge() {
+	intvls.Intervals = intvls.mergedSorted()
+	intvls.Sorted = true
+}
+
+// Union returns a new Intervals holding every value covered by intvls or
+// other, merged into canonical form.
+func (intvls *intervals) Union(other Intervals) Intervals {
+	a := intvls.mergedSorted()
+	b := canonicalOf(other)
+	return intvls.newResult(coalesce(mergeSorted(a, b)))
+}
+
+// Intersect returns a new Intervals holding only the values covered by both
+// intvls and other.
+func (intvls *intervals) Intersect(other Intervals) Intervals {
+	a := intvls.mergedSorted()
+	b := canonicalOf(other)
+
+	result := []*Interval{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		lo := max(a[i].Low, b[j].Low)
+		hi := min(a[i].High, b[j].High)
+		if lo <= hi {
+			result = append(result, &Interval{Low: lo, High: hi})
+		}
+		if a[i].High < b[j].High {
+			i++
+		} else {
+			j++
+		}
+	}
+	return intvls.newResult(result)
+}
+
+// Difference returns a new Intervals holding the values covered by intvls
+// but not by other.
+func (intvls *intervals) Difference(other Intervals) Intervals {
+	a := intvls.mergedSorted()
+	b := canonicalOf(other)
+
+	result := []*Interval{}
+	j := 0
+	for _, seg := range a {
+		lo, hi := seg.Low, seg.High
+		for j < len(b) && b[j].High < lo {
+			j++
+		}
+		k := j
+		for k < len(b) && lo <= hi && b[k].Low <= hi {
+			if b[k].Low > lo {
+				result = append(result, &Interval{Low: lo, High: b[k].Low - 1})
+			}
+			lo = b[k].High + 1
+			k++
+		}
+		if lo <= hi {
+			result = append(result, &Interval{Low: lo, High: hi})
+		}
+	}
+	return intvls.newResult(result)
+}
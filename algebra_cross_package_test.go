@@ -0,0 +1,30 @@
+package interval_test
+
+import (
+	"testing"
+
+	interval "github.com/daniel-gil/intervals"
+	"github.com/daniel-gil/intervals/list"
+)
+
+// TestUnionAcceptsOtherImplementations verifies that Union (and by
+// extension Intersect/Difference, which share the same canonicalOf path)
+// works with any Intervals implementation, not just the slice-backed one
+// returned by NewIntervals - e.g. the IntervalList Adapter from the list
+// subpackage.
+func TestUnionAcceptsOtherImplementations(t *testing.T) {
+	a := interval.NewIntervals(0, 100)
+	a.Add(&interval.Interval{Low: 1, High: 5})
+
+	l := list.NewIntervalList()
+	l.InsertInterval(&interval.Interval{Low: 3, High: 10})
+	adapter := list.NewAdapter(l, 0, 100)
+
+	union := a.Union(adapter)
+	union.Merge()
+
+	got := union.FindIntervalsForValue(7)
+	if len(got) == 0 {
+		t.Fatalf("Union(adapter) did not cover value 7, got matches: %v", got)
+	}
+}
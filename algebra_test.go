@@ -0,0 +1,120 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newFixture(ranges ...[2]int) Intervals {
+	intvls := NewIntervals(0, 100)
+	for _, r := range ranges {
+		intvls.Add(&Interval{Low: r[0], High: r[1]})
+	}
+	return intvls
+}
+
+func asRanges(intvls Intervals) [][2]int {
+	impl := intvls.(*intervals)
+	impl.Sort()
+	out := make([][2]int, 0, len(impl.Intervals))
+	for _, intvl := range impl.Intervals {
+		out = append(out, [2]int{intvl.Low, intvl.High})
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Intervals
+		want [][2]int
+	}{
+		{"empty", newFixture(), [][2]int{}},
+		{"single", newFixture([2]int{1, 5}), [][2]int{{1, 5}}},
+		{"touching", newFixture([2]int{1, 5}, [2]int{6, 10}), [][2]int{{1, 10}}},
+		{"overlapping", newFixture([2]int{1, 5}, [2]int{3, 8}), [][2]int{{1, 8}}},
+		{"disjoint", newFixture([2]int{1, 5}, [2]int{10, 15}), [][2]int{{1, 5}, {10, 15}}},
+		{"full containment", newFixture([2]int{1, 10}, [2]int{3, 5}), [][2]int{{1, 10}}},
+		{"unsorted input", newFixture([2]int{10, 15}, [2]int{1, 5}), [][2]int{{1, 5}, {10, 15}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.in.Merge()
+			got := asRanges(tt.in)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Intervals
+		want [][2]int
+	}{
+		{"both empty", newFixture(), newFixture(), [][2]int{}},
+		{"one empty", newFixture([2]int{1, 5}), newFixture(), [][2]int{{1, 5}}},
+		{"disjoint", newFixture([2]int{1, 5}), newFixture([2]int{10, 15}), [][2]int{{1, 5}, {10, 15}}},
+		{"touching", newFixture([2]int{1, 5}), newFixture([2]int{6, 10}), [][2]int{{1, 10}}},
+		{"overlapping", newFixture([2]int{1, 5}, [2]int{20, 25}), newFixture([2]int{3, 22}), [][2]int{{1, 25}}},
+		{"full containment", newFixture([2]int{1, 10}), newFixture([2]int{3, 5}), [][2]int{{1, 10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := asRanges(tt.a.Union(tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Union() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Intervals
+		want [][2]int
+	}{
+		{"both empty", newFixture(), newFixture(), [][2]int{}},
+		{"one empty", newFixture([2]int{1, 5}), newFixture(), [][2]int{}},
+		{"disjoint", newFixture([2]int{1, 5}), newFixture([2]int{10, 15}), [][2]int{}},
+		{"touching but not overlapping", newFixture([2]int{1, 5}), newFixture([2]int{6, 10}), [][2]int{}},
+		{"partial overlap", newFixture([2]int{1, 10}), newFixture([2]int{5, 15}), [][2]int{{5, 10}}},
+		{"full containment", newFixture([2]int{1, 10}), newFixture([2]int{3, 5}), [][2]int{{3, 5}}},
+		{"multiple segments", newFixture([2]int{1, 5}, [2]int{10, 15}), newFixture([2]int{3, 12}), [][2]int{{3, 5}, {10, 12}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := asRanges(tt.a.Intersect(tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Intervals
+		want [][2]int
+	}{
+		{"both empty", newFixture(), newFixture(), [][2]int{}},
+		{"subtract empty", newFixture([2]int{1, 5}), newFixture(), [][2]int{{1, 5}}},
+		{"disjoint", newFixture([2]int{1, 5}), newFixture([2]int{10, 15}), [][2]int{{1, 5}}},
+		{"full containment removed", newFixture([2]int{1, 10}), newFixture([2]int{1, 10}), [][2]int{}},
+		{"hole in the middle", newFixture([2]int{1, 10}), newFixture([2]int{4, 6}), [][2]int{{1, 3}, {7, 10}}},
+		{"overlap at the start", newFixture([2]int{1, 10}), newFixture([2]int{-5, 3}), [][2]int{{4, 10}}},
+		{"overlap at the end", newFixture([2]int{1, 10}), newFixture([2]int{8, 20}), [][2]int{{1, 7}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := asRanges(tt.a.Difference(tt.b))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Difference() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,12 +1,11 @@
 package main
 
 import (
-	"bufio"
 	"fmt"
 	"log"
 	"os"
 
-	"github.com/daniel-gil/intervals"
+	interval "github.com/daniel-gil/intervals"
 )
 
 const (
@@ -16,64 +15,31 @@ const (
 
 func main() {
 	filename := "data.txt"
-	xys, err := readData(filename)
+	intvls, err := readData(filename)
 	if err != nil {
 		log.Fatalf("could not read %s: %v", filename, err)
 	}
-	intvls := initIntervals(xys)
-	ip := intervals.NewPlot(intvls.IsLowInclusive(), intvls.IsHighInclusive())
+	ip := interval.NewPlot(true, true)
 	err = ip.PlotData("out.png", intvls, true, true, true, true)
 	if err != nil {
 		log.Fatalf("could not plot data: %v", err)
 	}
 }
 
-type xy struct{ x, y int }
-
-func readData(path string) ([]xy, error) {
+func readData(path string) (interval.Intervals, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
-	var xys []xy
 
-	// read line by line using a scanner (because we don't know if the file will be huge)
-	s := bufio.NewScanner(f)
-	for s.Scan() {
-		var low, high int
-		_, err := fmt.Sscanf(s.Text(), "%d,%d", &low, &high)
-		if err != nil {
-			log.Printf("discarding bad data point %v: %v", s.Text(), err)
-			continue
-		}
-		if low > high {
-			log.Printf("discarding bad data point (low, high)=(%v): low can not be greater than high", s.Text())
-			continue
-		}
-		xys = append(xys, xy{low, high})
-	}
-	if err := s.Err(); err != nil {
-		return nil, fmt.Errorf("could not scan: %v", err)
+	intvls := interval.NewIntervals(MinX, MaxX)
+	rd := interval.NewReader(f, interval.ParseLowCommaHigh)
+	rd.OnError = func(line string, err error) {
+		fmt.Printf("discarding bad data point %q: %v\n", line, err)
 	}
-	return xys, nil
-}
-
-func initIntervals(xys []xy) intervals.Intervals {
-	// initialize Intervals
-	minLow := MinX
-	maxHigh := MaxX
-	lowInclusive := true
-	highInclusive := true
-	selfAdjustMinLow := false
-	selfAdjustMaxHigh := true
-	intvls := intervals.New(minLow, maxHigh, lowInclusive, highInclusive, selfAdjustMinLow, selfAdjustMaxHigh)
-
-	for _, xy := range xys {
-		err := intvls.AddInterval(&intervals.Interval{Low: xy.x, High: xy.y})
-		if err != nil {
-			fmt.Printf("invalid interval discarded: %v\n", err)
-		}
+	if err := interval.ReadAll(rd, intvls); err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
 	}
-	return intvls
+	return intvls, nil
 }
@@ -29,6 +29,21 @@ type Intervals interface {
 
 	// Print first sorts (if necessary) and then displays graphically the interval sequence
 	Print() string
+
+	// Union returns a new Intervals covering every value in either intvls or other
+	Union(other Intervals) Intervals
+
+	// Intersect returns a new Intervals covering only the values common to both intvls and other
+	Intersect(other Intervals) Intervals
+
+	// Difference returns a new Intervals covering the values in intvls that are not in other
+	Difference(other Intervals) Intervals
+
+	// Merge sorts (if necessary) and coalesces overlapping or touching intervals in place
+	Merge()
+
+	// Bounds returns the configured [minLow, maxHigh] range of intvls
+	Bounds() (minLow, maxHigh int)
 }
 
 type intervals struct {
@@ -51,6 +66,10 @@ func NewIntervals(minLow int, maxHigh int) Intervals {
 	}
 }
 
+func (intvls *intervals) Bounds() (minLow, maxHigh int) {
+	return intvls.MinLow, intvls.MaxHigh
+}
+
 func (intvls *intervals) Add(itvl *Interval) {
 	intvls.Intervals = append(intvls.Intervals, itvl)
 	intvls.Sorted = false
@@ -0,0 +1,92 @@
+package list
+
+import (
+	interval "github.com/daniel-gil/intervals"
+)
+
+// Adapter makes an IntervalList satisfy interval.Intervals, so callers that
+// only need streaming-friendly inserts can still reuse the slice
+// implementation's gap/overlap/render logic instead of reimplementing it.
+type Adapter struct {
+	*IntervalList
+	minLow, maxHigh int
+}
+
+// NewAdapter wraps list in an Adapter bounded by [minLow, maxHigh], the same
+// bounds interval.NewIntervals takes.
+func NewAdapter(list *IntervalList, minLow, maxHigh int) *Adapter {
+	return &Adapter{IntervalList: list, minLow: minLow, maxHigh: maxHigh}
+}
+
+// snapshot copies the list's current nodes into a slice-backed Intervals so
+// the richer set operations can be delegated instead of duplicated.
+func (a *Adapter) snapshot() interval.Intervals {
+	snap := interval.NewIntervals(a.minLow, a.maxHigh)
+	for n := a.Front(); n != nil; n = n.Next() {
+		snap.Add(&interval.Interval{Low: n.Low, High: n.High})
+	}
+	return snap
+}
+
+// Add inserts itvl, merging it with any overlapping or touching neighbours.
+func (a *Adapter) Add(itvl *interval.Interval) {
+	a.InsertInterval(itvl)
+}
+
+// Sort is a no-op: InsertInterval keeps the list sorted at all times.
+func (a *Adapter) Sort() {}
+
+// Bounds returns the adapter's configured [minLow, maxHigh] range.
+func (a *Adapter) Bounds() (minLow, maxHigh int) {
+	return a.minLow, a.maxHigh
+}
+
+func (a *Adapter) Gaps() []*interval.Interval {
+	return a.snapshot().Gaps()
+}
+
+func (a *Adapter) Overlapped() []*interval.Interval {
+	return a.snapshot().Overlapped()
+}
+
+func (a *Adapter) FindIntervalsForValue(value int) []*interval.Interval {
+	var matches []*interval.Interval
+	for n := a.Front(); n != nil; n = n.Next() {
+		if n.Low <= value && value <= n.High {
+			matches = append(matches, &interval.Interval{Low: n.Low, High: n.High})
+		}
+	}
+	return matches
+}
+
+func (a *Adapter) Print() string {
+	return a.snapshot().Print()
+}
+
+func (a *Adapter) Union(other interval.Intervals) interval.Intervals {
+	return a.snapshot().Union(other)
+}
+
+func (a *Adapter) Intersect(other interval.Intervals) interval.Intervals {
+	return a.snapshot().Intersect(other)
+}
+
+func (a *Adapter) Difference(other interval.Intervals) interval.Intervals {
+	return a.snapshot().Difference(other)
+}
+
+// Merge coalesces touching neighbours in place. InsertInterval already keeps
+// the list coalesced, but Overlay can leave touching runs such as [1,3],[4,6].
+func (a *Adapter) Merge() {
+	for n := a.Front(); n != nil; {
+		next := n.Next()
+		if next != nil && next.Low <= n.High+1 {
+			if next.High > n.High {
+				n.High = next.High
+			}
+			removeRange(next, next.next)
+			continue
+		}
+		n = next
+	}
+}
@@ -0,0 +1,151 @@
+// Package list provides a doubly-linked-list backed implementation of
+// interval storage, IntervalList, as an alternative to the slice-backed
+// implementation in the root interval package. Where the slice
+// implementation appends on Add and re-sorts and re-scans its whole backing
+// array whenever a canonical view is needed, IntervalList keeps its nodes in
+// a sorted chain that InsertInterval keeps canonical, so that a single
+// insertion only ever splices the neighbours it actually overlaps with - the
+// shape streaming callers like a line-by-line reader need.
+package list
+
+import (
+	"math"
+
+	interval "github.com/daniel-gil/intervals"
+)
+
+// Node is one link of an IntervalList. Low and High are inclusive bounds,
+// following the same convention as interval.Interval.
+type Node struct {
+	Low, High  int
+	prev, next *Node
+	sentinel   bool
+}
+
+// Next returns the following node, or nil once iteration reaches the end
+// of the list (i.e. the next node would be the tail sentinel).
+func (n *Node) Next() *Node {
+	if n.next == nil || n.next.sentinel {
+		return nil
+	}
+	return n.next
+}
+
+// IntervalList stores a sorted, non-overlapping sequence of intervals as a
+// doubly-linked list with sentinel head/tail nodes at -inf/+inf, so that
+// inserting before the first or after the last real node never needs a
+// nil check.
+type IntervalList struct {
+	head, tail *Node
+}
+
+// NewIntervalList returns an empty IntervalList.
+func NewIntervalList() *IntervalList {
+	head := &Node{Low: math.MinInt64, High: math.MinInt64, sentinel: true}
+	tail := &Node{Low: math.MaxInt64, High: math.MaxInt64, sentinel: true}
+	head.next = tail
+	tail.prev = head
+	return &IntervalList{head: head, tail: tail}
+}
+
+// Front returns the first real node in the list, or nil if the list is
+// empty. Callers walk the rest of the list via node.Next, which yields
+// every subsequent node up to (but not including) the tail sentinel.
+func (l *IntervalList) Front() *Node {
+	if l.head.next.sentinel {
+		return nil
+	}
+	return l.head.next
+}
+
+func insertBefore(at, n *Node) {
+	prev := at.prev
+	n.prev = prev
+	n.next = at
+	prev.next = n
+	at.prev = n
+}
+
+// removeRange unlinks every node in [from, to), leaving the list wired
+// directly from from.prev to to.
+func removeRange(from, to *Node) {
+	if from == to {
+		return
+	}
+	from.prev.next = to
+	to.prev = from.prev
+}
+
+// InsertInterval splices itvl into the list, merging it with any
+// overlapping or touching neighbours. Finding the insertion point walks the
+// list from the front, so it costs O(n) in the number of nodes before itvl;
+// the splice itself is O(k) where k is the number of nodes itvl overlaps
+// with, since it only ever re-links that local run.
+func (l *IntervalList) InsertInterval(itvl *interval.Interval) {
+	lo, hi := itvl.Low, itvl.High
+
+	cur := l.head.next
+	for cur != l.tail && cur.High < lo-1 {
+		cur = cur.next
+	}
+	start := cur
+	for cur != l.tail && cur.Low <= hi+1 {
+		if cur.Low < lo {
+			lo = cur.Low
+		}
+		if cur.High > hi {
+			hi = cur.High
+		}
+		cur = cur.next
+	}
+
+	removeRange(start, cur)
+	insertBefore(cur, &Node{Low: lo, High: hi})
+}
+
+// Overlay inserts itvl as the last writer for its range: any existing
+// coverage within [itvl.Low, itvl.High] is trimmed or removed rather than
+// merged, so the new interval's bounds win outright instead of being
+// absorbed into a wider union the way InsertInterval would.
+func (l *IntervalList) Overlay(itvl *interval.Interval) {
+	lo, hi := itvl.Low, itvl.High
+
+	cur := l.head.next
+	for cur != l.tail && cur.High < lo {
+		cur = cur.next
+	}
+	start := cur
+
+	var left *Node
+	if cur != l.tail && cur.Low < lo {
+		left = &Node{Low: cur.Low, High: lo - 1}
+	}
+	for cur != l.tail && cur.Low <= hi {
+		cur = cur.next
+	}
+	var right *Node
+	if cur != start && cur.prev.High > hi {
+		right = &Node{Low: hi + 1, High: cur.prev.High}
+	}
+
+	// Drop the whole overlapped run, then splice the left remainder, the
+	// new interval and the right remainder back in, in that order, so the
+	// list stays sorted.
+	removeRange(start, cur)
+	if left != nil {
+		insertBefore(cur, left)
+	}
+	insertBefore(cur, &Node{Low: lo, High: hi})
+	if right != nil {
+		insertBefore(cur, right)
+	}
+}
+
+// Len returns the number of intervals currently stored.
+func (l *IntervalList) Len() int {
+	n := 0
+	for cur := l.Front(); cur != nil; cur = cur.Next() {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,52 @@
+package list
+
+import (
+	"math/rand"
+	"testing"
+
+	interval "github.com/daniel-gil/intervals"
+)
+
+// genIntervals produces n small, overlap-heavy intervals scattered across a
+// range proportional to n, the shape a streaming reader like readData in
+// the example would see.
+func genIntervals(n int) []*interval.Interval {
+	r := rand.New(rand.NewSource(42))
+	out := make([]*interval.Interval, n)
+	for i := 0; i < n; i++ {
+		low := r.Intn(n * 2)
+		out[i] = &interval.Interval{Low: low, High: low + r.Intn(20)}
+	}
+	return out
+}
+
+func benchmarkIntervalListInsert(b *testing.B, n int) {
+	data := genIntervals(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l := NewIntervalList()
+		for _, itvl := range data {
+			l.InsertInterval(itvl)
+		}
+	}
+}
+
+func benchmarkSliceInsert(b *testing.B, n int) {
+	data := genIntervals(n)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		intvls := interval.NewIntervalsDefault()
+		for _, itvl := range data {
+			intvls.Add(itvl)
+			intvls.Merge()
+		}
+	}
+}
+
+func BenchmarkIntervalListInsert_10k(b *testing.B)  { benchmarkIntervalListInsert(b, 10_000) }
+func BenchmarkIntervalListInsert_100k(b *testing.B) { benchmarkIntervalListInsert(b, 100_000) }
+func BenchmarkIntervalListInsert_1M(b *testing.B)   { benchmarkIntervalListInsert(b, 1_000_000) }
+
+func BenchmarkSliceInsert_10k(b *testing.B)  { benchmarkSliceInsert(b, 10_000) }
+func BenchmarkSliceInsert_100k(b *testing.B) { benchmarkSliceInsert(b, 100_000) }
+func BenchmarkSliceInsert_1M(b *testing.B)   { benchmarkSliceInsert(b, 1_000_000) }
@@ -0,0 +1,72 @@
+package list
+
+import (
+	"reflect"
+	"testing"
+
+	interval "github.com/daniel-gil/intervals"
+)
+
+func ranges(l *IntervalList) [][2]int {
+	out := [][2]int{}
+	for n := l.Front(); n != nil; n = n.Next() {
+		out = append(out, [2]int{n.Low, n.High})
+	}
+	return out
+}
+
+func TestInsertInterval(t *testing.T) {
+	tests := []struct {
+		name   string
+		insert [][2]int
+		want   [][2]int
+	}{
+		{"single", [][2]int{{1, 5}}, [][2]int{{1, 5}}},
+		{"disjoint", [][2]int{{1, 5}, {10, 15}}, [][2]int{{1, 5}, {10, 15}}},
+		{"touching merges", [][2]int{{1, 5}, {6, 10}}, [][2]int{{1, 10}}},
+		{"overlapping merges", [][2]int{{1, 5}, {3, 8}}, [][2]int{{1, 8}}},
+		{"bridges two neighbours", [][2]int{{1, 5}, {10, 15}, {4, 11}}, [][2]int{{1, 15}}},
+		{"out of order", [][2]int{{10, 15}, {1, 5}}, [][2]int{{1, 5}, {10, 15}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewIntervalList()
+			for _, r := range tt.insert {
+				l.InsertInterval(&interval.Interval{Low: r[0], High: r[1]})
+			}
+			got := ranges(l)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InsertInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	l := NewIntervalList()
+	l.InsertInterval(&interval.Interval{Low: 1, High: 10})
+
+	l.Overlay(&interval.Interval{Low: 4, High: 6})
+	if got, want := ranges(l), [][2]int{{1, 3}, {4, 6}, {7, 10}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Overlay() punch hole = %v, want %v", got, want)
+	}
+
+	l.Overlay(&interval.Interval{Low: 8, High: 20})
+	if got, want := ranges(l), [][2]int{{1, 3}, {4, 6}, {7, 7}, {8, 20}}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Overlay() trim right = %v, want %v", got, want)
+	}
+}
+
+func TestAdapterFindIntervalsForValue(t *testing.T) {
+	l := NewIntervalList()
+	l.InsertInterval(&interval.Interval{Low: 1, High: 5})
+	l.InsertInterval(&interval.Interval{Low: 10, High: 15})
+
+	a := NewAdapter(l, 0, 20)
+	if matches := a.FindIntervalsForValue(3); len(matches) != 1 || matches[0].Low != 1 {
+		t.Errorf("FindIntervalsForValue(3) = %v, want [1,5]", matches)
+	}
+	if matches := a.FindIntervalsForValue(7); len(matches) != 0 {
+		t.Errorf("FindIntervalsForValue(7) = %v, want none", matches)
+	}
+}
@@ -0,0 +1,191 @@
+package interval
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+	"gonum.org/v1/plot/vg/vgsvg"
+)
+
+// Plot renders an Intervals value, either as a gonum chart (PNG/SVG) or as
+// the same block/separator graphic intervals.Print draws, but written to
+// any io.Writer and with a configurable width, block size and symbol set
+// instead of the hard-coded constants Print uses.
+type Plot struct {
+	lowInclusive  bool
+	highInclusive bool
+
+	Width         int
+	BlockSize     int
+	EmptySymbol   string
+	FullSymbol    string
+	OverlapSymbol string
+
+	intvls                                          Intervals
+	showIntervals, showGaps, showOverlaps, showAxis bool
+}
+
+// NewPlot returns a Plot configured with this package's default block size
+// and symbols, matching intervals.Print.
+func NewPlot(lowInclusive, highInclusive bool) *Plot {
+	return &Plot{
+		lowInclusive:  lowInclusive,
+		highInclusive: highInclusive,
+		Width:         100,
+		BlockSize:     10,
+		EmptySymbol:   "◌",
+		FullSymbol:    "◎",
+		OverlapSymbol: "●",
+	}
+}
+
+// PlotData renders intvls to filename, picking a renderer from the file
+// extension (.png, .svg, anything else falls back to ASCII).
+func (p *Plot) PlotData(filename string, intvls Intervals, showIntervals, showGaps, showOverlaps, showAxis bool) error {
+	p.intvls = intvls
+	p.showIntervals = showIntervals
+	p.showGaps = showGaps
+	p.showOverlaps = showOverlaps
+	p.showAxis = showAxis
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("could not create %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".svg":
+		return p.RenderSVG(f)
+	case ".png":
+		return p.RenderPNG(f)
+	default:
+		return p.RenderASCII(f)
+	}
+}
+
+// build assembles the gonum plot shared by RenderPNG and RenderSVG.
+func (p *Plot) build() (*plot.Plot, error) {
+	plt := plot.New()
+	plt.Title.Text = "Intervals"
+
+	minLow, maxHigh := p.intvls.Bounds()
+	if p.showIntervals {
+		bars, err := plotter.NewBarChart(intervalValues(p.intvls.FindIntervalsForValue, minLow, maxHigh), vg.Points(1))
+		if err != nil {
+			return nil, fmt.Errorf("could not build interval bars: %w", err)
+		}
+		plt.Add(bars)
+	}
+	if p.showGaps {
+		plt.Add(rangeMarkers(p.intvls.Gaps()))
+	}
+	if p.showOverlaps {
+		plt.Add(rangeMarkers(p.intvls.Overlapped()))
+	}
+	if p.showAxis {
+		plt.X.Min = float64(minLow)
+		plt.X.Max = float64(maxHigh)
+	}
+	return plt, nil
+}
+
+// RenderPNG draws the gonum chart as a PNG onto w.
+func (p *Plot) RenderPNG(w io.Writer) error {
+	plt, err := p.build()
+	if err != nil {
+		return err
+	}
+	width, height := vg.Points(float64(p.Width*p.BlockSize)), vg.Points(200)
+	img := vgimg.New(width, height)
+	plt.Draw(draw.New(img))
+	_, err = vgimg.PngCanvas{Canvas: img}.WriteTo(w)
+	return err
+}
+
+// RenderSVG draws the gonum chart as an SVG onto w.
+func (p *Plot) RenderSVG(w io.Writer) error {
+	plt, err := p.build()
+	if err != nil {
+		return err
+	}
+	width, height := vg.Points(float64(p.Width*p.BlockSize)), vg.Points(200)
+	c := vgsvg.New(width, height)
+	plt.Draw(draw.New(c))
+	_, err = c.WriteTo(w)
+	return err
+}
+
+// RenderASCII writes the same block/separator graphic as intervals.Print,
+// honoring p.Width, p.BlockSize and p.EmptySymbol/FullSymbol/OverlapSymbol
+// instead of Print's hard-coded constants.
+func (p *Plot) RenderASCII(w io.Writer) error {
+	minLow, maxHigh := p.intvls.Bounds()
+	width := p.Width
+	if width <= 0 || minLow+width > maxHigh {
+		width = maxHigh - minLow + 1
+	}
+
+	gaps := p.intvls.Gaps()
+	overlapped := p.intvls.Overlapped()
+
+	var graph strings.Builder
+	for i := 0; i < width; i++ {
+		value := minLow + i
+		switch {
+		case inAnyRange(value, gaps):
+			graph.WriteString(p.EmptySymbol)
+		case inAnyRange(value, overlapped):
+			graph.WriteString(p.OverlapSymbol)
+		default:
+			graph.WriteString(p.FullSymbol)
+		}
+		if p.BlockSize > 0 && (i+1)%p.BlockSize == 0 {
+			graph.WriteString("║")
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "╠%s╣\n", graph.String())
+	return err
+}
+
+func inAnyRange(value int, ranges []*Interval) bool {
+	for _, r := range ranges {
+		if inBetweenInclusive(value, r.Low, r.High) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeMarkers turns a slice of Interval into a single gonum line at y=1
+// through each range's Low and High, used for the Gaps/Overlapped overlays.
+// Consecutive ranges are joined by that same line.
+func rangeMarkers(ranges []*Interval) plot.Plotter {
+	pts := make(plotter.XYs, 0, len(ranges)*2)
+	for _, r := range ranges {
+		pts = append(pts, plotter.XY{X: float64(r.Low), Y: 1}, plotter.XY{X: float64(r.High), Y: 1})
+	}
+	line, _ := plotter.NewLine(pts)
+	return line
+}
+
+// intervalValues builds a bar-chart series with one bar per value in
+// [minLow, maxHigh], set to 1 wherever the value falls inside an interval.
+func intervalValues(find func(int) []*Interval, minLow, maxHigh int) plotter.Values {
+	values := make(plotter.Values, maxHigh-minLow+1)
+	for i := range values {
+		if len(find(minLow+i)) > 0 {
+			values[i] = 1
+		}
+	}
+	return values
+}
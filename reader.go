@@ -0,0 +1,129 @@
+package interval
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// LineParser turns one line of input into an Interval.
+type LineParser func(line string) (*Interval, error)
+
+// ParseError records a line that a LineParser rejected, so library users
+// can decide for themselves whether to log it, collect it, or fail.
+type ParseError struct {
+	Line string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("could not parse %q: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseLowCommaHigh parses the "low,high" format, e.g. "3,7".
+func ParseLowCommaHigh(line string) (*Interval, error) {
+	return parseScanf(line, "%d,%d")
+}
+
+// ParseLowSpaceHigh parses the "low high" format, e.g. "3 7".
+func ParseLowSpaceHigh(line string) (*Interval, error) {
+	return parseScanf(line, "%d %d")
+}
+
+// ParseBracketed parses the "[low,high]" format, e.g. "[3,7]".
+func ParseBracketed(line string) (*Interval, error) {
+	return parseScanf(line, "[%d,%d]")
+}
+
+func parseScanf(line, format string) (*Interval, error) {
+	var low, high int
+	if _, err := fmt.Sscanf(line, format, &low, &high); err != nil {
+		return nil, fmt.Errorf("expected format %q: %w", format, err)
+	}
+	if low > high {
+		return nil, fmt.Errorf("low (%d) can not be greater than high (%d)", low, high)
+	}
+	return &Interval{Low: low, High: high}, nil
+}
+
+// ParseJSONLine parses a JSON-lines format, e.g. {"low":3,"high":7}.
+func ParseJSONLine(line string) (*Interval, error) {
+	var payload struct {
+		Low  int `json:"low"`
+		High int `json:"high"`
+	}
+	if err := json.Unmarshal([]byte(line), &payload); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if payload.Low > payload.High {
+		return nil, fmt.Errorf("low (%d) can not be greater than high (%d)", payload.Low, payload.High)
+	}
+	return &Interval{Low: payload.Low, High: payload.High}, nil
+}
+
+// Reader scans intervals out of r line by line using parser, collecting any
+// rejected lines as ParseErrors instead of logging them directly.
+type Reader struct {
+	scanner *bufio.Scanner
+	parser  LineParser
+
+	// OnError, if set, is called for every line parser rejects, in
+	// addition to it being appended to Errors.
+	OnError func(line string, err error)
+	Errors  []ParseError
+}
+
+// NewReader returns a Reader that parses each non-blank line of r with
+// parser.
+func NewReader(r io.Reader, parser LineParser) *Reader {
+	return &Reader{
+		scanner: bufio.NewScanner(r),
+		parser:  parser,
+	}
+}
+
+// Read returns the next successfully parsed interval, skipping and
+// recording any lines parser rejects. It returns io.EOF once r is
+// exhausted.
+func (rd *Reader) Read() (*Interval, error) {
+	for rd.scanner.Scan() {
+		line := strings.TrimSpace(rd.scanner.Text())
+		if line == "" {
+			continue
+		}
+		itvl, err := rd.parser(line)
+		if err != nil {
+			perr := ParseError{Line: line, Err: err}
+			rd.Errors = append(rd.Errors, perr)
+			if rd.OnError != nil {
+				rd.OnError(line, err)
+			}
+			continue
+		}
+		return itvl, nil
+	}
+	if err := rd.scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not scan: %w", err)
+	}
+	return nil, io.EOF
+}
+
+// ReadAll drains rd, adding every successfully parsed interval to intvls.
+func ReadAll(rd *Reader, intvls Intervals) error {
+	for {
+		itvl, err := rd.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		intvls.Add(itvl)
+	}
+}
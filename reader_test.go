@@ -0,0 +1,55 @@
+package interval
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReaderParsers(t *testing.T) {
+	tests := []struct {
+		name   string
+		parser LineParser
+		input  string
+		want   []*Interval
+	}{
+		{"comma", ParseLowCommaHigh, "1,5\n10,20\n", []*Interval{{Low: 1, High: 5}, {Low: 10, High: 20}}},
+		{"space", ParseLowSpaceHigh, "1 5\n10 20\n", []*Interval{{Low: 1, High: 5}, {Low: 10, High: 20}}},
+		{"bracketed", ParseBracketed, "[1,5]\n[10,20]\n", []*Interval{{Low: 1, High: 5}, {Low: 10, High: 20}}},
+		{"json", ParseJSONLine, "{\"low\":1,\"high\":5}\n{\"low\":10,\"high\":20}\n", []*Interval{{Low: 1, High: 5}, {Low: 10, High: 20}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rd := NewReader(strings.NewReader(tt.input), tt.parser)
+			var got []*Interval
+			for {
+				itvl, err := rd.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Read() error: %v", err)
+				}
+				got = append(got, itvl)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Read() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if *got[i] != *tt.want[i] {
+					t.Errorf("Read() [%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReaderCollectsParseErrors(t *testing.T) {
+	rd := NewReader(strings.NewReader("1,5\nnot-a-range\n20,10\n10,20\n"), ParseLowCommaHigh)
+	if err := ReadAll(rd, NewIntervalsDefault()); err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	if len(rd.Errors) != 2 {
+		t.Fatalf("len(Errors) = %d, want 2: %v", len(rd.Errors), rd.Errors)
+	}
+}
@@ -0,0 +1,138 @@
+package interval
+
+import "sort"
+
+// mapping is one piecewise-linear segment of a TransformMap: every value in
+// [srcStart, srcStart+length-1] is shifted by (destStart - srcStart).
+type mapping struct {
+	destStart int
+	srcStart  int
+	length    int
+}
+
+// TransformMap maps integers (and whole Intervals) through a set of
+// piecewise-linear segments, passing through any value not covered by a
+// segment unchanged.
+type TransformMap struct {
+	mappings []mapping
+}
+
+// NewTransformMap returns an empty TransformMap: every value maps to
+// itself until a segment is added.
+func NewTransformMap() *TransformMap {
+	return &TransformMap{}
+}
+
+// AddMapping registers a segment: every x in [source, source+length-1] maps
+// to dest+(x-source).
+func (t *TransformMap) AddMapping(dest, source, length int) {
+	t.mappings = append(t.mappings, mapping{destStart: dest, srcStart: source, length: length})
+	sort.Slice(t.mappings, func(i, j int) bool { return t.mappings[i].srcStart < t.mappings[j].srcStart })
+}
+
+// find returns the segment covering x, if any.
+func (t *TransformMap) find(x int) (mapping, bool) {
+	i := sort.Search(len(t.mappings), func(i int) bool {
+		return t.mappings[i].srcStart+t.mappings[i].length > x
+	})
+	if i < len(t.mappings) && t.mappings[i].srcStart <= x {
+		return t.mappings[i], true
+	}
+	return mapping{}, false
+}
+
+// Map translates a single point through the map, passing it through
+// unchanged if it isn't covered by any segment.
+func (t *TransformMap) Map(x int) int {
+	if m, ok := t.find(x); ok {
+		return m.destStart + (x - m.srcStart)
+	}
+	return x
+}
+
+// split breaks [lo, hi] into the minimal run of sub-ranges such that each
+// sub-range lies entirely within one segment, or entirely within a gap
+// between segments. It runs in O(k log n) where k is the number of
+// sub-ranges produced and n is the number of segments.
+func (t *TransformMap) split(lo, hi int) []*Interval {
+	segs := []*Interval{}
+	i := sort.Search(len(t.mappings), func(i int) bool {
+		return t.mappings[i].srcStart+t.mappings[i].length-1 >= lo
+	})
+	for lo <= hi {
+		if i >= len(t.mappings) {
+			segs = append(segs, &Interval{Low: lo, High: hi})
+			break
+		}
+		m := t.mappings[i]
+		srcHigh := m.srcStart + m.length - 1
+		if hi < m.srcStart {
+			segs = append(segs, &Interval{Low: lo, High: hi})
+			break
+		}
+		if lo < m.srcStart {
+			segs = append(segs, &Interval{Low: lo, High: m.srcStart - 1})
+			lo = m.srcStart
+			continue
+		}
+		segHi := min(hi, srcHigh)
+		segs = append(segs, &Interval{Low: lo, High: segHi})
+		lo = segHi + 1
+		i++
+	}
+	return segs
+}
+
+// MapInterval translates a whole range through the map. The input is split
+// at every segment boundary it crosses; each covered sub-range is shifted
+// by that segment's offset and each gap sub-range is returned unchanged, in
+// O((n+k) log n).
+func (t *TransformMap) MapInterval(iv *Interval) []*Interval {
+	result := []*Interval{}
+	for _, seg := range t.split(iv.Low, iv.High) {
+		offset := 0
+		if m, ok := t.find(seg.Low); ok {
+			offset = m.destStart - m.srcStart
+		}
+		result = append(result, &Interval{Low: seg.Low + offset, High: seg.High + offset})
+	}
+	return result
+}
+
+// Compose fuses two transform stages into one, so that for every x,
+// Compose(a, b).Map(x) == b.Map(a.Map(x)). This pre-fuses a pipeline of
+// maps once instead of re-running every stage for every point passed
+// through it.
+//
+// Composition covers three regions: the portion of a's mapped output that b
+// also remaps (a's segments split at b's boundaries, below); the portion of
+// a's mapped output that b passes through unchanged (the same split, but
+// b.Map(seg.Low) is then just an identity); and the portion of b's source
+// domain that a never touches at all, which still needs to carry b's offset
+// since x reaches b unchanged. Without that last region, any value covered
+// by b but not by a would fall through to the identity instead of being
+// shifted by b's offset.
+func Compose(a, b *TransformMap) *TransformMap {
+	out := NewTransformMap()
+	for _, m := range a.mappings {
+		destLo := m.destStart
+		destHi := m.destStart + m.length - 1
+		for _, seg := range b.split(destLo, destHi) {
+			srcStart := m.srcStart + (seg.Low - destLo)
+			length := seg.High - seg.Low + 1
+			out.AddMapping(b.Map(seg.Low), srcStart, length)
+		}
+	}
+	for _, m := range b.mappings {
+		srcLo := m.srcStart
+		srcHi := m.srcStart + m.length - 1
+		for _, seg := range a.split(srcLo, srcHi) {
+			if _, ok := a.find(seg.Low); ok {
+				continue // already emitted above, via a's own segment
+			}
+			offset := m.destStart - m.srcStart
+			out.AddMapping(seg.Low+offset, seg.Low, seg.High-seg.Low+1)
+		}
+	}
+	return out
+}
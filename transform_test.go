@@ -0,0 +1,85 @@
+package interval
+
+import "testing"
+
+func TestTransformMapMap(t *testing.T) {
+	tm := NewTransformMap()
+	tm.AddMapping(50, 98, 2)
+	tm.AddMapping(52, 50, 48)
+
+	tests := []struct {
+		x    int
+		want int
+	}{
+		{98, 50},
+		{99, 51},
+		{53, 55},
+		{10, 10}, // unmapped, passes through
+		{100, 100},
+	}
+	for _, tt := range tests {
+		if got := tm.Map(tt.x); got != tt.want {
+			t.Errorf("Map(%d) = %d, want %d", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestTransformMapMapInterval(t *testing.T) {
+	tm := NewTransformMap()
+	tm.AddMapping(50, 98, 2)  // [98,99] -> [50,51]
+	tm.AddMapping(52, 50, 48) // [50,97] -> [52,99]
+
+	// [40,100] straddles: the unmapped [40,49] gap, the [50,97] segment,
+	// and the [98,99] segment, plus the unmapped point 100.
+	got := tm.MapInterval(&Interval{Low: 40, High: 100})
+	want := []*Interval{
+		{Low: 40, High: 49},
+		{Low: 52, High: 99},
+		{Low: 50, High: 51},
+		{Low: 100, High: 100},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("MapInterval() returned %d ranges, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if *got[i] != *want[i] {
+			t.Errorf("MapInterval() segment %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	a := NewTransformMap()
+	a.AddMapping(10, 0, 10) // [0,9] -> [10,19]
+
+	b := NewTransformMap()
+	b.AddMapping(100, 10, 5)  // [10,14] -> [100,104]
+	b.AddMapping(200, 15, 5)  // [15,19] -> [200,204]
+
+	composed := Compose(a, b)
+	for x := 0; x < 10; x++ {
+		want := b.Map(a.Map(x))
+		if got := composed.Map(x); got != want {
+			t.Errorf("Compose(a, b).Map(%d) = %d, want %d", x, got, want)
+		}
+	}
+}
+
+func TestComposeCoversOtherMapsDomainUntouchedByFirst(t *testing.T) {
+	// a only covers [0,9]; b covers [50,59], a range a never touches. Values
+	// in that range must still pass through a unchanged and then be mapped
+	// by b, not fall through to the identity.
+	a := NewTransformMap()
+	a.AddMapping(100, 0, 10) // [0,9] -> [100,109]
+
+	b := NewTransformMap()
+	b.AddMapping(200, 50, 10) // [50,59] -> [200,209]
+
+	composed := Compose(a, b)
+	for _, x := range []int{50, 55, 59} {
+		want := b.Map(a.Map(x))
+		if got := composed.Map(x); got != want {
+			t.Errorf("Compose(a, b).Map(%d) = %d, want %d", x, got, want)
+		}
+	}
+}